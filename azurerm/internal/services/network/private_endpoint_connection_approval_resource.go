@@ -0,0 +1,247 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-03-01/network"
+	"github.com/hashicorp/go-azure-helpers/response"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmPrivateEndpointConnectionApproval() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmPrivateEndpointConnectionApprovalCreateUpdate,
+		Read:   resourceArmPrivateEndpointConnectionApprovalRead,
+		Update: resourceArmPrivateEndpointConnectionApprovalCreateUpdate,
+		Delete: resourceArmPrivateEndpointConnectionApprovalDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"private_link_service_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"private_endpoint_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.Approved),
+					string(network.Rejected),
+					string(network.Disconnected),
+				}, false),
+			},
+
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 140),
+			},
+
+			"actions_required": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceArmPrivateEndpointConnectionApprovalCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.PrivateLinkServicesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	privateLinkServiceId := d.Get("private_link_service_id").(string)
+	privateEndpointId := d.Get("private_endpoint_id").(string)
+
+	id, err := azure.ParseAzureResourceID(privateLinkServiceId)
+	if err != nil {
+		return fmt.Errorf("parsing Private Link Service ID %q: %+v", privateLinkServiceId, err)
+	}
+	resourceGroup := id.ResourceGroup
+	serviceName := id.Path["privateLinkServices"]
+
+	connection, err := findPrivateEndpointConnectionByEndpointId(ctx, client, resourceGroup, serviceName, privateEndpointId)
+	if err != nil {
+		return err
+	}
+	if connection == nil {
+		return fmt.Errorf("no Private Endpoint Connection was found on Private Link Service %q (Resource Group %q) for Private Endpoint %q", serviceName, resourceGroup, privateEndpointId)
+	}
+
+	connectionName := ""
+	if connection.Name != nil {
+		connectionName = *connection.Name
+	}
+	if connectionName == "" {
+		return fmt.Errorf("retrieving name of Private Endpoint Connection for Private Endpoint %q", privateEndpointId)
+	}
+
+	parameters := network.PrivateEndpointConnection{
+		PrivateEndpointConnectionProperties: &network.PrivateEndpointConnectionProperties{
+			PrivateLinkServiceConnectionState: &network.PrivateLinkServiceConnectionState{
+				Status: network.PrivateEndpointServiceConnectionStatus(d.Get("status").(string)),
+			},
+		},
+	}
+	if description := d.Get("description").(string); description != "" {
+		parameters.PrivateEndpointConnectionProperties.PrivateLinkServiceConnectionState.Description = utils.String(description)
+	}
+	if actionsRequired := d.Get("actions_required").(string); actionsRequired != "" {
+		parameters.PrivateEndpointConnectionProperties.PrivateLinkServiceConnectionState.ActionsRequired = utils.String(actionsRequired)
+	}
+
+	future, err := client.UpdatePrivateEndpointConnection(ctx, resourceGroup, serviceName, connectionName, parameters)
+	if err != nil {
+		return fmt.Errorf("updating Private Endpoint Connection %q (Private Link Service %q / Resource Group %q): %+v", connectionName, serviceName, resourceGroup, err)
+	}
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for update of Private Endpoint Connection %q (Private Link Service %q / Resource Group %q): %+v", connectionName, serviceName, resourceGroup, err)
+	}
+
+	resp, err := client.GetPrivateEndpointConnection(ctx, resourceGroup, serviceName, connectionName)
+	if err != nil {
+		return fmt.Errorf("retrieving Private Endpoint Connection %q (Private Link Service %q / Resource Group %q): %+v", connectionName, serviceName, resourceGroup, err)
+	}
+	if resp.ID == nil || *resp.ID == "" {
+		return fmt.Errorf("API returns a nil/empty id on Private Endpoint Connection %q (Private Link Service %q / Resource Group %q)", connectionName, serviceName, resourceGroup)
+	}
+	d.SetId(*resp.ID)
+
+	return resourceArmPrivateEndpointConnectionApprovalRead(d, meta)
+}
+
+func resourceArmPrivateEndpointConnectionApprovalRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.PrivateLinkServicesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serviceName := id.Path["privateLinkServices"]
+	connectionName := id.Path["privateEndpointConnections"]
+
+	resp, err := client.GetPrivateEndpointConnection(ctx, resourceGroup, serviceName, connectionName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] Private Endpoint Connection %q does not exist - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("reading Private Endpoint Connection %q (Private Link Service %q / Resource Group %q): %+v", connectionName, serviceName, resourceGroup, err)
+	}
+
+	if props := resp.PrivateEndpointConnectionProperties; props != nil {
+		if endpoint := props.PrivateEndpoint; endpoint != nil {
+			d.Set("private_endpoint_id", endpoint.ID)
+		}
+
+		if state := props.PrivateLinkServiceConnectionState; state != nil {
+			d.Set("status", string(state.Status))
+			if state.Description != nil {
+				d.Set("description", *state.Description)
+			}
+			if state.ActionsRequired != nil {
+				d.Set("actions_required", *state.ActionsRequired)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceArmPrivateEndpointConnectionApprovalDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.PrivateLinkServicesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serviceName := id.Path["privateLinkServices"]
+	connectionName := id.Path["privateEndpointConnections"]
+
+	parameters := network.PrivateEndpointConnection{
+		PrivateEndpointConnectionProperties: &network.PrivateEndpointConnectionProperties{
+			PrivateLinkServiceConnectionState: &network.PrivateLinkServiceConnectionState{
+				Status:      network.Disconnected,
+				Description: utils.String("Disconnected by Terraform"),
+			},
+		},
+	}
+
+	future, err := client.UpdatePrivateEndpointConnection(ctx, resourceGroup, serviceName, connectionName, parameters)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("disconnecting Private Endpoint Connection %q (Private Link Service %q / Resource Group %q): %+v", connectionName, serviceName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("waiting for disconnection of Private Endpoint Connection %q (Private Link Service %q / Resource Group %q): %+v", connectionName, serviceName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func findPrivateEndpointConnectionByEndpointId(ctx context.Context, client *network.PrivateLinkServicesClient, resourceGroup string, serviceName string, privateEndpointId string) (*network.PrivateEndpointConnection, error) {
+	resp, err := client.ListPrivateEndpointConnections(ctx, resourceGroup, serviceName)
+	if err != nil {
+		return nil, fmt.Errorf("listing Private Endpoint Connections on Private Link Service %q (Resource Group %q): %+v", serviceName, resourceGroup, err)
+	}
+	if resp.Value == nil {
+		return nil, nil
+	}
+
+	return findPrivateEndpointConnectionInList(*resp.Value, privateEndpointId), nil
+}
+
+// findPrivateEndpointConnectionInList is the matching logic behind
+// findPrivateEndpointConnectionByEndpointId, split out so it can be unit tested against
+// fabricated API responses without a live PrivateLinkServicesClient.
+func findPrivateEndpointConnectionInList(connections []network.PrivateEndpointConnection, privateEndpointId string) *network.PrivateEndpointConnection {
+	for _, connection := range connections {
+		if props := connection.PrivateEndpointConnectionProperties; props != nil {
+			if endpoint := props.PrivateEndpoint; endpoint != nil && endpoint.ID != nil && *endpoint.ID == privateEndpointId {
+				conn := connection
+				return &conn
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package network
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-03-01/network"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestFindPrivateEndpointConnectionInList(t *testing.T) {
+	connectionFor := func(name string, privateEndpointId string) network.PrivateEndpointConnection {
+		return network.PrivateEndpointConnection{
+			Name: utils.String(name),
+			PrivateEndpointConnectionProperties: &network.PrivateEndpointConnectionProperties{
+				PrivateEndpoint: &network.PrivateEndpoint{
+					ID: utils.String(privateEndpointId),
+				},
+			},
+		}
+	}
+
+	endpoint1 := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/privateEndpoints/endpoint1"
+	endpoint2 := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/privateEndpoints/endpoint2"
+
+	connections := []network.PrivateEndpointConnection{
+		connectionFor("connection1", endpoint1),
+		connectionFor("connection2", endpoint2),
+	}
+
+	t.Run("matches the connection for the given endpoint", func(t *testing.T) {
+		result := findPrivateEndpointConnectionInList(connections, endpoint2)
+		if result == nil {
+			t.Fatal("expected a match but got nil")
+		}
+		if result.Name == nil || *result.Name != "connection2" {
+			t.Fatalf("expected to match `connection2`, got %+v", result)
+		}
+	})
+
+	t.Run("returns nil when no connection targets the given endpoint", func(t *testing.T) {
+		result := findPrivateEndpointConnectionInList(connections, "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/privateEndpoints/unrelated")
+		if result != nil {
+			t.Fatalf("expected no match but got %+v", result)
+		}
+	})
+
+	t.Run("returns nil for an empty list", func(t *testing.T) {
+		result := findPrivateEndpointConnectionInList(nil, endpoint1)
+		if result != nil {
+			t.Fatalf("expected no match but got %+v", result)
+		}
+	})
+
+	t.Run("skips connections with no PrivateEndpoint set", func(t *testing.T) {
+		withoutEndpoint := []network.PrivateEndpointConnection{
+			{
+				Name:                                utils.String("connection3"),
+				PrivateEndpointConnectionProperties: &network.PrivateEndpointConnectionProperties{},
+			},
+		}
+		result := findPrivateEndpointConnectionInList(withoutEndpoint, endpoint1)
+		if result != nil {
+			t.Fatalf("expected no match but got %+v", result)
+		}
+	})
+}
@@ -0,0 +1,93 @@
+package network
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+)
+
+func dataSourceArmPrivateEndpointConnection() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmPrivateEndpointConnectionRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"private_link_service_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"private_endpoint_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"actions_required": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceArmPrivateEndpointConnectionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.PrivateLinkServicesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	privateLinkServiceId := d.Get("private_link_service_id").(string)
+	privateEndpointId := d.Get("private_endpoint_id").(string)
+
+	id, err := azure.ParseAzureResourceID(privateLinkServiceId)
+	if err != nil {
+		return fmt.Errorf("parsing Private Link Service ID %q: %+v", privateLinkServiceId, err)
+	}
+	resourceGroup := id.ResourceGroup
+	serviceName := id.Path["privateLinkServices"]
+
+	connection, err := findPrivateEndpointConnectionByEndpointId(ctx, client, resourceGroup, serviceName, privateEndpointId)
+	if err != nil {
+		return err
+	}
+	if connection == nil {
+		return fmt.Errorf("no Private Endpoint Connection was found on Private Link Service %q (Resource Group %q) for Private Endpoint %q", serviceName, resourceGroup, privateEndpointId)
+	}
+
+	if connection.ID == nil || *connection.ID == "" {
+		return fmt.Errorf("API returns a nil/empty id on Private Endpoint Connection (Private Link Service %q / Resource Group %q)", serviceName, resourceGroup)
+	}
+	d.SetId(*connection.ID)
+
+	if props := connection.PrivateEndpointConnectionProperties; props != nil {
+		if state := props.PrivateLinkServiceConnectionState; state != nil {
+			d.Set("status", string(state.Status))
+			if state.Description != nil {
+				d.Set("description", *state.Description)
+			}
+			if state.ActionsRequired != nil {
+				d.Set("actions_required", *state.ActionsRequired)
+			}
+		}
+	}
+
+	return nil
+}
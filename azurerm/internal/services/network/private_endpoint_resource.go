@@ -1,8 +1,11 @@
 package network
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"net"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-03-01/network"
@@ -35,6 +38,8 @@ func resourceArmPrivateEndpoint() *schema.Resource {
 			Delete: schema.DefaultTimeout(60 * time.Minute),
 		},
 
+		CustomizeDiff: resourceArmPrivateEndpointCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -57,7 +62,6 @@ func resourceArmPrivateEndpoint() *schema.Resource {
 			"private_dns_zone_group": {
 				Type:     schema.TypeList,
 				Optional: true,
-				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"name": {
@@ -82,6 +86,37 @@ func resourceArmPrivateEndpoint() *schema.Resource {
 										Type:     schema.TypeString,
 										Required: true,
 									},
+									"record_sets": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"name": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"type": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"fqdn": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"ttl": {
+													Type:     schema.TypeInt,
+													Computed: true,
+												},
+												"ip_addresses": {
+													Type:     schema.TypeList,
+													Computed: true,
+													Elem: &schema.Schema{
+														Type: schema.TypeString,
+													},
+												},
+											},
+										},
+									},
 								},
 							},
 						},
@@ -92,7 +127,6 @@ func resourceArmPrivateEndpoint() *schema.Resource {
 			"private_service_connection": {
 				Type:     schema.TypeList,
 				Required: true,
-				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"name": {
@@ -134,6 +168,42 @@ func resourceArmPrivateEndpoint() *schema.Resource {
 				},
 			},
 
+			"ip_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: ValidatePrivateLinkName,
+						},
+						"group_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"member_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"private_ip_address": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsIPAddress,
+						},
+					},
+				},
+			},
+
+			"application_security_group_ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: azure.ValidateResourceID,
+				},
+			},
+
 			"custom_dns_configs": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -159,6 +229,146 @@ func resourceArmPrivateEndpoint() *schema.Resource {
 	}
 }
 
+func resourceArmPrivateEndpointCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if err := validatePrivateServiceConnections(d); err != nil {
+		return err
+	}
+
+	ipConfigurations := d.Get("ip_configuration").([]interface{})
+	if len(ipConfigurations) == 0 {
+		return nil
+	}
+
+	subnetId := d.Get("subnet_id").(string)
+	if subnetId == "" {
+		return nil
+	}
+
+	id, err := azure.ParseAzureResourceID(subnetId)
+	if err != nil {
+		return fmt.Errorf("parsing `subnet_id`: %+v", err)
+	}
+
+	client := meta.(*clients.Client).Network.SubnetsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	subnet, err := client.Get(ctx, id.ResourceGroup, id.Path["virtualNetworks"], id.Path["subnets"], "")
+	if err != nil {
+		return fmt.Errorf("retrieving Subnet %q (Virtual Network %q / Resource Group %q): %+v", id.Path["subnets"], id.Path["virtualNetworks"], id.ResourceGroup, err)
+	}
+
+	props := subnet.SubnetPropertiesFormat
+	if props == nil {
+		return nil
+	}
+
+	addressPrefixes := make([]string, 0)
+	if props.AddressPrefix != nil {
+		addressPrefixes = append(addressPrefixes, *props.AddressPrefix)
+	}
+	if props.AddressPrefixes != nil {
+		addressPrefixes = append(addressPrefixes, *props.AddressPrefixes...)
+	}
+
+	for _, item := range ipConfigurations {
+		v := item.(map[string]interface{})
+		name := v["name"].(string)
+		privateIpAddress := v["private_ip_address"].(string)
+
+		inSubnet, err := privateIpAddressIsWithinAddressPrefixes(privateIpAddress, addressPrefixes)
+		if err != nil {
+			return fmt.Errorf("`ip_configuration.%s.private_ip_address`: %+v", name, err)
+		}
+
+		if !inSubnet {
+			return fmt.Errorf("`ip_configuration.%s.private_ip_address` %q is not within the address space of Subnet %q", name, privateIpAddress, subnetId)
+		}
+	}
+
+	return nil
+}
+
+// privateIpAddressIsWithinAddressPrefixes reports whether ipAddress falls within any of the
+// given CIDR prefixes. A malformed prefix is skipped rather than treated as an error, since a
+// Subnet can have multiple address prefixes and one being unparsable shouldn't prevent matching
+// against the rest.
+func privateIpAddressIsWithinAddressPrefixes(ipAddress string, addressPrefixes []string) (bool, error) {
+	ip := net.ParseIP(ipAddress)
+	if ip == nil {
+		return false, fmt.Errorf("%q is not a valid IP address", ipAddress)
+	}
+
+	for _, prefix := range addressPrefixes {
+		_, cidr, err := net.ParseCIDR(prefix)
+		if err != nil {
+			continue
+		}
+		if cidr.Contains(ip) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// validatePrivateServiceConnections gives plan-time feedback for two mistakes that otherwise
+// only surface as a mid-apply API error: a manual connection request with no message for the
+// approver, and a connection with no `subresource_names` targeting a provider resource that
+// requires a groupId (the API rejects this with "is missing required parameter 'group Id'").
+func validatePrivateServiceConnections(d *schema.ResourceDiff) error {
+	privateServiceConnections := d.Get("private_service_connection").([]interface{})
+
+	for _, item := range privateServiceConnections {
+		v := item.(map[string]interface{})
+		name := v["name"].(string)
+		isManual := v["is_manual_connection"].(bool)
+		requestMessage := v["request_message"].(string)
+		subresourceNames := v["subresource_names"].([]interface{})
+		privateConnectionResourceId := v["private_connection_resource_id"].(string)
+
+		if err := validatePrivateServiceConnectionConfig(isManual, requestMessage, len(subresourceNames), privateConnectionResourceId); err != nil {
+			return fmt.Errorf("`private_service_connection.%s`: %+v", name, err)
+		}
+	}
+
+	return nil
+}
+
+// validatePrivateServiceConnectionConfig holds the per-connection rules checked by
+// validatePrivateServiceConnections, split out so they can be unit tested without
+// constructing a *schema.ResourceDiff.
+func validatePrivateServiceConnectionConfig(isManual bool, requestMessage string, subresourceNameCount int, privateConnectionResourceId string) error {
+	if isManual && requestMessage == "" {
+		return fmt.Errorf("`request_message` must be set when `is_manual_connection` is `true`")
+	}
+
+	if subresourceNameCount == 0 && privateConnectionResourceTypeRequiresGroupId(privateConnectionResourceId) {
+		return fmt.Errorf("`subresource_names` must be populated, ensure that the 'group Id' for the target resource is set")
+	}
+
+	return nil
+}
+
+// privateConnectionResourceTypeRequiresGroupId reports whether the target of
+// `private_connection_resource_id` is a provider resource type that requires a
+// groupId in `subresource_names`. A Private Link Service has no groupId concept -
+// connecting to one with empty `subresource_names` is valid and common.
+func privateConnectionResourceTypeRequiresGroupId(resourceId string) bool {
+	id, err := azure.ParseAzureResourceID(resourceId)
+	if err != nil {
+		return true
+	}
+
+	for key := range id.Path {
+		if strings.EqualFold(key, "privateLinkServices") {
+			return false
+		}
+	}
+
+	return true
+}
+
 func resourceArmPrivateEndpointCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Network.PrivateEndpointClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
@@ -187,6 +397,7 @@ func resourceArmPrivateEndpointCreateUpdate(d *schema.ResourceData, meta interfa
 	location := azure.NormalizeLocation(d.Get("location").(string))
 	privateDnsZoneGroup := d.Get("private_dns_zone_group").([]interface{})
 	privateServiceConnections := d.Get("private_service_connection").([]interface{})
+	ipConfigurations := d.Get("ip_configuration").([]interface{})
 	subnetId := d.Get("subnet_id").(string)
 
 	parameters := network.PrivateEndpoint{
@@ -194,6 +405,7 @@ func resourceArmPrivateEndpointCreateUpdate(d *schema.ResourceData, meta interfa
 		PrivateEndpointProperties: &network.PrivateEndpointProperties{
 			PrivateLinkServiceConnections:       expandArmPrivateLinkEndpointServiceConnection(privateServiceConnections, false),
 			ManualPrivateLinkServiceConnections: expandArmPrivateLinkEndpointServiceConnection(privateServiceConnections, true),
+			IPConfigurations:                    expandArmPrivateEndpointIPConfigurations(ipConfigurations),
 			Subnet: &network.Subnet{
 				ID: utils.String(subnetId),
 			},
@@ -213,16 +425,19 @@ func resourceArmPrivateEndpointCreateUpdate(d *schema.ResourceData, meta interfa
 		return fmt.Errorf("waiting for creation of Private Endpoint %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
 
-	dnsGroupParameters := expandArmPrivateDnsZoneGroup(privateDnsZoneGroup)
+	dnsClient := meta.(*clients.Client).Network.PrivateDnsZoneGroupClient
+	for _, dnsGroupParameters := range expandArmPrivateDnsZoneGroups(privateDnsZoneGroup) {
+		dnsGroupName := dnsGroupParameters.Name
+		if dnsGroupName == nil {
+			continue
+		}
 
-	if dnsGroupName := dnsGroupParameters.Name; dnsGroupName != nil {
-		dnsClient := meta.(*clients.Client).Network.PrivateDnsZoneGroupClient
 		future, err := dnsClient.CreateOrUpdate(ctx, resourceGroup, name, *dnsGroupName, dnsGroupParameters)
 		if err != nil {
-			return fmt.Errorf("creating Private Endpoint DNS Zone Group %q (Resource Group %q): %+v", dnsGroupName, resourceGroup, err)
+			return fmt.Errorf("creating Private Endpoint DNS Zone Group %q (Resource Group %q): %+v", *dnsGroupName, resourceGroup, err)
 		}
 		if err = future.WaitForCompletionRef(ctx, dnsClient.Client); err != nil {
-			return fmt.Errorf("waiting for creation of Private Endpoint DNS Zone Group %q (Resource Group %q): %+v", dnsGroupName, resourceGroup, err)
+			return fmt.Errorf("waiting for creation of Private Endpoint DNS Zone Group %q (Resource Group %q): %+v", *dnsGroupName, resourceGroup, err)
 		}
 	}
 
@@ -235,6 +450,22 @@ func resourceArmPrivateEndpointCreateUpdate(d *schema.ResourceData, meta interfa
 	}
 	d.SetId(*resp.ID)
 
+	// Always reconcile the NIC's Application Security Groups with configuration, even when
+	// `application_security_group_ids` is an empty list - that's how a previously-associated
+	// set of ASGs gets detached again.
+	applicationSecurityGroupIds := d.Get("application_security_group_ids").([]interface{})
+	nicsClient := meta.(*clients.Client).Network.InterfacesClient
+	if props := resp.PrivateEndpointProperties; props != nil && props.NetworkInterfaces != nil {
+		for _, nic := range *props.NetworkInterfaces {
+			if nic.ID == nil || *nic.ID == "" {
+				continue
+			}
+			if err := setApplicationSecurityGroupsOnNic(ctx, nicsClient, *nic.ID, applicationSecurityGroupIds); err != nil {
+				return fmt.Errorf("associating Application Security Groups with Private Endpoint %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+	}
+
 	return resourceArmPrivateEndpointRead(d, meta)
 }
 
@@ -269,42 +500,66 @@ func resourceArmPrivateEndpointRead(d *schema.ResourceData, meta interface{}) er
 	}
 
 	if props := resp.PrivateEndpointProperties; props != nil {
-		privateIpAddress := ""
+		orderedPrivateIpAddresses := make([]string, 0)
+		applicationSecurityGroupIds := make([]interface{}, 0)
 
-		if nics := props.NetworkInterfaces; nics != nil && len(*nics) > 0 {
-			nic := (*nics)[0]
-			if nic.ID != nil && *nic.ID != "" {
-				privateIpAddress = getPrivateIpAddress(ctx, nicsClient, *nic.ID)
+		if nics := props.NetworkInterfaces; nics != nil {
+			for _, nic := range *nics {
+				if nic.ID == nil || *nic.ID == "" {
+					continue
+				}
+				orderedPrivateIpAddresses = append(orderedPrivateIpAddresses, getOrderedPrivateIpAddresses(ctx, nicsClient, *nic.ID)...)
+				if len(applicationSecurityGroupIds) == 0 {
+					applicationSecurityGroupIds = getApplicationSecurityGroupIds(ctx, nicsClient, *nic.ID)
+				}
 			}
 		}
+		d.Set("application_security_group_ids", applicationSecurityGroupIds)
 
+		// The platform does not document a stable mapping between a NIC's IP configuration
+		// name and the `private_service_connection` that requested it, so rather than guess
+		// at a name match we rely on both lists sharing the same order as the connections
+		// were submitted in (PrivateLinkServiceConnections, then ManualPrivateLinkServiceConnections) -
+		// the same order `flattenArmPrivateLinkEndpointServiceConnection` produces below.
 		flattenedConnection := flattenArmPrivateLinkEndpointServiceConnection(props.PrivateLinkServiceConnections, props.ManualPrivateLinkServiceConnections)
-		for _, item := range flattenedConnection {
+		for i, item := range flattenedConnection {
 			v := item.(map[string]interface{})
-			v["private_ip_address"] = privateIpAddress
+			if i < len(orderedPrivateIpAddresses) {
+				v["private_ip_address"] = orderedPrivateIpAddresses[i]
+			} else if len(orderedPrivateIpAddresses) > 0 {
+				v["private_ip_address"] = orderedPrivateIpAddresses[0]
+			}
 		}
 		if err := d.Set("private_service_connection", flattenedConnection); err != nil {
 			return fmt.Errorf("setting `private_service_connection`: %+v", err)
 		}
 
-		dnsGroupParameters := expandArmPrivateDnsZoneGroup(d.Get("private_dns_zone_group").([]interface{}))
+		dnsZoneGroups := make([]interface{}, 0)
+		for _, dnsGroupParameters := range expandArmPrivateDnsZoneGroups(d.Get("private_dns_zone_group").([]interface{})) {
+			zoneGroupName := dnsGroupParameters.Name
+			if zoneGroupName == nil {
+				continue
+			}
 
-		if zoneGroupName := dnsGroupParameters.Name; zoneGroupName != nil {
 			dnsResp, err := dnsClient.Get(ctx, resourceGroup, name, *zoneGroupName)
 			if err != nil {
-				return fmt.Errorf("reading Private Endpoint %q DNS Zone Group %q (Resource Group %q): %+v", name, zoneGroupName, resourceGroup, err)
+				return fmt.Errorf("reading Private Endpoint %q DNS Zone Group %q (Resource Group %q): %+v", name, *zoneGroupName, resourceGroup, err)
 			}
-			d.Set("private_dns_zone_group", flattenArmPrivateDnsZoneGroup(&dnsResp))
+			dnsZoneGroups = append(dnsZoneGroups, flattenArmPrivateDnsZoneGroup(&dnsResp)...)
 		}
+		d.Set("private_dns_zone_group", dnsZoneGroups)
 
 		subnetId := ""
 		if subnet := props.Subnet; subnet != nil {
 			subnetId = *subnet.ID
 		}
 		d.Set("subnet_id", subnetId)
-		foo := flattenArmCustomDnsConfigs(props.CustomDNSConfigs)
-		d.Set("custom_dns_configs", foo)
-		log.Printf("\n\n\n\n\n\n\n********************************\nflattenArmCustomDnsConfigs == %+v\nlen == %d\n********************************\n\n\n\n\n\n\n\n\n\n\n\n", foo, len(*props.CustomDNSConfigs))
+		if err := d.Set("ip_configuration", flattenArmPrivateEndpointIPConfigurations(props.IPConfigurations)); err != nil {
+			return fmt.Errorf("setting `ip_configuration`: %+v", err)
+		}
+		customDnsConfigs := flattenArmCustomDnsConfigs(props.CustomDNSConfigs)
+		d.Set("custom_dns_configs", customDnsConfigs)
+		log.Printf("[DEBUG] retrieved %d `custom_dns_configs` for Private Endpoint %q (Resource Group %q)", len(customDnsConfigs), name, resourceGroup)
 	}
 
 	return tags.FlattenAndSet(d, resp.Tags)
@@ -339,6 +594,178 @@ func resourceArmPrivateEndpointDelete(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
+// getOrderedPrivateIpAddresses returns the private IP address of each IP configuration on the
+// Private Endpoint's NIC, in the NIC's own IPConfigurations order. There is no documented
+// mapping between an IP configuration's name and the private_service_connection it was
+// provisioned for, so callers match these back to connections positionally.
+func getOrderedPrivateIpAddresses(ctx context.Context, nicsClient *network.InterfacesClient, nicId string) []string {
+	result := make([]string, 0)
+
+	id, err := azure.ParseAzureResourceID(nicId)
+	if err != nil {
+		return result
+	}
+
+	nic, err := nicsClient.Get(ctx, id.ResourceGroup, id.Path["networkInterfaces"], "")
+	if err != nil {
+		return result
+	}
+
+	if props := nic.InterfacePropertiesFormat; props != nil && props.IPConfigurations != nil {
+		for _, ipConfig := range *props.IPConfigurations {
+			if ipConfig.InterfaceIPConfigurationPropertiesFormat == nil {
+				continue
+			}
+			if privateIpAddress := ipConfig.InterfaceIPConfigurationPropertiesFormat.PrivateIPAddress; privateIpAddress != nil {
+				result = append(result, *privateIpAddress)
+			}
+		}
+	}
+
+	return result
+}
+
+// setApplicationSecurityGroupsOnNic patches every IP configuration on the Private Endpoint's
+// NIC so that it is a member of the given Application Security Groups, allowing the endpoint
+// to be targeted by ASG-scoped NSG rules.
+func setApplicationSecurityGroupsOnNic(ctx context.Context, nicsClient *network.InterfacesClient, nicId string, applicationSecurityGroupIds []interface{}) error {
+	id, err := azure.ParseAzureResourceID(nicId)
+	if err != nil {
+		return err
+	}
+	nicResourceGroup := id.ResourceGroup
+	nicName := id.Path["networkInterfaces"]
+
+	nic, err := nicsClient.Get(ctx, nicResourceGroup, nicName, "")
+	if err != nil {
+		return fmt.Errorf("retrieving Network Interface %q (Resource Group %q): %+v", nicName, nicResourceGroup, err)
+	}
+
+	applicationSecurityGroups := make([]network.ApplicationSecurityGroup, 0)
+	for _, asgId := range applicationSecurityGroupIds {
+		applicationSecurityGroups = append(applicationSecurityGroups, network.ApplicationSecurityGroup{
+			ID: utils.String(asgId.(string)),
+		})
+	}
+
+	if props := nic.InterfacePropertiesFormat; props != nil && props.IPConfigurations != nil {
+		for i := range *props.IPConfigurations {
+			ipConfig := &(*props.IPConfigurations)[i]
+			if ipConfig.InterfaceIPConfigurationPropertiesFormat == nil {
+				continue
+			}
+			ipConfig.InterfaceIPConfigurationPropertiesFormat.ApplicationSecurityGroups = &applicationSecurityGroups
+		}
+	}
+
+	future, err := nicsClient.CreateOrUpdate(ctx, nicResourceGroup, nicName, nic)
+	if err != nil {
+		return fmt.Errorf("updating Network Interface %q (Resource Group %q): %+v", nicName, nicResourceGroup, err)
+	}
+	return future.WaitForCompletionRef(ctx, nicsClient.Client)
+}
+
+func getApplicationSecurityGroupIds(ctx context.Context, nicsClient *network.InterfacesClient, nicId string) []interface{} {
+	results := make([]interface{}, 0)
+
+	id, err := azure.ParseAzureResourceID(nicId)
+	if err != nil {
+		return results
+	}
+
+	nic, err := nicsClient.Get(ctx, id.ResourceGroup, id.Path["networkInterfaces"], "")
+	if err != nil {
+		return results
+	}
+
+	if props := nic.InterfacePropertiesFormat; props != nil && props.IPConfigurations != nil {
+		for _, ipConfig := range *props.IPConfigurations {
+			if ipConfig.InterfaceIPConfigurationPropertiesFormat == nil || ipConfig.ApplicationSecurityGroups == nil {
+				continue
+			}
+			for _, asg := range *ipConfig.ApplicationSecurityGroups {
+				if asg.ID != nil {
+					results = append(results, *asg.ID)
+				}
+			}
+			if len(results) > 0 {
+				return results
+			}
+		}
+	}
+
+	return results
+}
+
+func expandArmPrivateEndpointIPConfigurations(input []interface{}) *[]network.PrivateEndpointIPConfiguration {
+	results := make([]network.PrivateEndpointIPConfiguration, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+		name := v["name"].(string)
+		groupId := v["group_id"].(string)
+		memberName := v["member_name"].(string)
+		privateIpAddress := v["private_ip_address"].(string)
+
+		result := network.PrivateEndpointIPConfiguration{
+			Name: utils.String(name),
+			PrivateEndpointIPConfigurationProperties: &network.PrivateEndpointIPConfigurationProperties{
+				PrivateIPAddress: utils.String(privateIpAddress),
+			},
+		}
+
+		if groupId != "" {
+			result.PrivateEndpointIPConfigurationProperties.GroupID = utils.String(groupId)
+		}
+		if memberName != "" {
+			result.PrivateEndpointIPConfigurationProperties.MemberName = utils.String(memberName)
+		}
+
+		results = append(results, result)
+	}
+
+	return &results
+}
+
+func flattenArmPrivateEndpointIPConfigurations(input *[]network.PrivateEndpointIPConfiguration) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		name := ""
+		if item.Name != nil {
+			name = *item.Name
+		}
+
+		groupId := ""
+		memberName := ""
+		privateIpAddress := ""
+
+		if props := item.PrivateEndpointIPConfigurationProperties; props != nil {
+			if props.GroupID != nil {
+				groupId = *props.GroupID
+			}
+			if props.MemberName != nil {
+				memberName = *props.MemberName
+			}
+			if props.PrivateIPAddress != nil {
+				privateIpAddress = *props.PrivateIPAddress
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":               name,
+			"group_id":           groupId,
+			"member_name":        memberName,
+			"private_ip_address": privateIpAddress,
+		})
+	}
+
+	return results
+}
+
 func expandArmPrivateLinkEndpointServiceConnection(input []interface{}, parseManual bool) *[]network.PrivateLinkServiceConnection {
 	results := make([]network.PrivateLinkServiceConnection, 0)
 
@@ -370,20 +797,15 @@ func expandArmPrivateLinkEndpointServiceConnection(input []interface{}, parseMan
 	return &results
 }
 
-func expandArmPrivateDnsZoneGroup(input []interface{}) network.PrivateDNSZoneGroup {
-	result := network.PrivateDNSZoneGroup{}
-	if len(input) == 0 {
-		return result
-	}
-
-	dnsZoneConfigs := make([]network.PrivateDNSZoneConfig, 0)
+func expandArmPrivateDnsZoneGroups(input []interface{}) []network.PrivateDNSZoneGroup {
+	results := make([]network.PrivateDNSZoneGroup, 0)
 
 	for _, item := range input {
 		v := item.(map[string]interface{})
 		name := v["name"].(string)
 		zoneConfigs := v["zone_config"].([]interface{})
 
-		result.Name = utils.String(name)
+		dnsZoneConfigs := make([]network.PrivateDNSZoneConfig, 0)
 
 		for _, zoneConfig := range zoneConfigs {
 			z := zoneConfig.(map[string]interface{})
@@ -399,13 +821,16 @@ func expandArmPrivateDnsZoneGroup(input []interface{}) network.PrivateDNSZoneGro
 
 			dnsZoneConfigs = append(dnsZoneConfigs, config)
 		}
-	}
 
-	result.PrivateDNSZoneGroupPropertiesFormat = &network.PrivateDNSZoneGroupPropertiesFormat{
-		PrivateDNSZoneConfigs: &dnsZoneConfigs,
+		results = append(results, network.PrivateDNSZoneGroup{
+			Name: utils.String(name),
+			PrivateDNSZoneGroupPropertiesFormat: &network.PrivateDNSZoneGroupPropertiesFormat{
+				PrivateDNSZoneConfigs: &dnsZoneConfigs,
+			},
+		})
 	}
 
-	return result
+	return results
 }
 
 func flattenArmPrivateDnsZoneGroup(customDnsGroup *network.PrivateDNSZoneGroup) []interface{} {
@@ -432,27 +857,61 @@ func flattenArmPrivateDnsZoneConfigs(input *[]network.PrivateDNSZoneConfig) []in
 	if input == nil {
 		return output
 	}
-	log.Printf("\n\n\n\n\n\n\n********************************\n")
 	for _, v := range *input {
 		result := make(map[string]interface{})
 
 		if name := v.Name; name != nil {
 			result["name"] = *name
 		}
-		if zoneId := v.PrivateDNSZonePropertiesFormat.PrivateDNSZoneID; zoneId != nil {
-			result["private_dns_zone_id"] = *zoneId
+		if props := v.PrivateDNSZonePropertiesFormat; props != nil {
+			if zoneId := props.PrivateDNSZoneID; zoneId != nil {
+				result["private_dns_zone_id"] = *zoneId
+			}
+			result["record_sets"] = flattenArmPrivateDnsZoneConfigRecordSets(props.RecordSets)
 		}
 
-		log.Printf("\nRS LEN  == %d\n", len(*v.PrivateDNSZonePropertiesFormat.RecordSets))
+		output = append(output, result)
+	}
+	return output
+}
 
-		for _, rs := range *v.PrivateDNSZonePropertiesFormat.RecordSets {
-			log.Printf("\nFQDN  == %s\nIPAddresses == %+v\n\n", *rs.Fqdn, rs.IPAddresses)
+func flattenArmPrivateDnsZoneConfigRecordSets(input *[]network.RecordSet) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		name := ""
+		if v.Name != nil {
+			name = *v.Name
 		}
 
-		output = append(output, result)
+		recordType := ""
+		if v.RecordType != nil {
+			recordType = *v.RecordType
+		}
+
+		fqdn := ""
+		if v.Fqdn != nil {
+			fqdn = *v.Fqdn
+		}
+
+		ttl := 0
+		if v.TTL != nil {
+			ttl = int(*v.TTL)
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":         name,
+			"type":         recordType,
+			"fqdn":         fqdn,
+			"ttl":          ttl,
+			"ip_addresses": utils.FlattenStringSlice(v.IPAddresses),
+		})
 	}
-	log.Printf("********************************\n\n\n\n\n\n\n\n\n\n\n\n")
-	return output
+
+	return results
 }
 
 func flattenArmCustomDnsConfigs(customDnsConfigs *[]network.CustomDNSConfigPropertiesFormat) []interface{} {
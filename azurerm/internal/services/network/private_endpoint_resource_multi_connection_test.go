@@ -0,0 +1,185 @@
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// TestAccAzureRMPrivateEndpoint_multipleServiceConnections guards against a regression in the
+// positional matching between a Private Endpoint's NIC IP configurations and its
+// `private_service_connection` blocks: with more than one connection on the same NIC, each
+// connection must read back the private IP address of its own IP configuration, not a shared
+// or empty value.
+func TestAccAzureRMPrivateEndpoint_multipleServiceConnections(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_endpoint", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMPrivateEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMPrivateEndpoint_multipleServiceConnections(data),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPrivateEndpointExists(data.ResourceName),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "private_service_connection.0.private_ip_address"),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "private_service_connection.1.private_ip_address"),
+					testCheckAzureRMPrivateEndpointServiceConnectionIPsAreDistinct(data.ResourceName, 2),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func testCheckAzureRMPrivateEndpointExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.AzureProvider.Meta().(*clients.Client).Network.PrivateEndpointClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		id, err := azure.ParseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		resourceGroup := id.ResourceGroup
+		name := id.Path["privateEndpoints"]
+
+		resp, err := client.Get(ctx, resourceGroup, name, "")
+		if err != nil {
+			return fmt.Errorf("bad: Get on PrivateEndpointClient: %+v", err)
+		}
+
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("bad: Private Endpoint %q (Resource Group %q) does not exist", name, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMPrivateEndpointDestroy(s *terraform.State) error {
+	client := acceptance.AzureProvider.Meta().(*clients.Client).Network.PrivateEndpointClient
+	ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_private_endpoint" {
+			continue
+		}
+
+		id, err := azure.ParseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		resourceGroup := id.ResourceGroup
+		name := id.Path["privateEndpoints"]
+
+		resp, err := client.Get(ctx, resourceGroup, name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("Private Endpoint %q (Resource Group %q) still exists", name, resourceGroup)
+	}
+
+	return nil
+}
+
+// testCheckAzureRMPrivateEndpointServiceConnectionIPsAreDistinct asserts that every
+// `private_service_connection.N.private_ip_address` in state is non-empty and that no two
+// connections were assigned the same IP - the failure mode of the ordered lists drifting out of
+// sync with each other.
+func testCheckAzureRMPrivateEndpointServiceConnectionIPsAreDistinct(resourceName string, connectionCount int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		seen := make(map[string]string)
+		for i := 0; i < connectionCount; i++ {
+			key := fmt.Sprintf("private_service_connection.%d.private_ip_address", i)
+			ip, ok := rs.Primary.Attributes[key]
+			if !ok || ip == "" {
+				return fmt.Errorf("%s was not set", key)
+			}
+			if existing, exists := seen[ip]; exists {
+				return fmt.Errorf("%s and %s both resolved to the same private IP address %q", existing, key, ip)
+			}
+			seen[ip] = key
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMPrivateEndpoint_multipleServiceConnections(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-privateendpoint-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvirtnet%d"
+  address_space       = ["10.5.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_subnet" "test" {
+  name                                           = "acctestsubnet%d"
+  resource_group_name                            = azurerm_resource_group.test.name
+  virtual_network_name                           = azurerm_virtual_network.test.name
+  address_prefixes                               = ["10.5.1.0/24"]
+  enforce_private_link_endpoint_network_policies = true
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_private_endpoint" "test" {
+  name                = "acctestprivateendpoint-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  subnet_id           = azurerm_subnet.test.id
+
+  private_service_connection {
+    name                           = "acctestprivateendpointconnection-blob-%d"
+    is_manual_connection           = false
+    private_connection_resource_id = azurerm_storage_account.test.id
+    subresource_names              = ["blob"]
+  }
+
+  private_service_connection {
+    name                           = "acctestprivateendpointconnection-file-%d"
+    is_manual_connection           = false
+    private_connection_resource_id = azurerm_storage_account.test.id
+    subresource_names              = ["file"]
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomString, data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
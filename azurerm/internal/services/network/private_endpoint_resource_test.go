@@ -0,0 +1,156 @@
+package network
+
+import "testing"
+
+func TestPrivateIpAddressIsWithinAddressPrefixes(t *testing.T) {
+	cases := []struct {
+		name            string
+		ipAddress       string
+		addressPrefixes []string
+		expected        bool
+		expectErr       bool
+	}{
+		{
+			name:            "address within single prefix",
+			ipAddress:       "10.0.1.5",
+			addressPrefixes: []string{"10.0.1.0/24"},
+			expected:        true,
+		},
+		{
+			name:            "address outside single prefix",
+			ipAddress:       "10.0.2.5",
+			addressPrefixes: []string{"10.0.1.0/24"},
+			expected:        false,
+		},
+		{
+			name:            "address within second of multiple prefixes",
+			ipAddress:       "10.0.2.5",
+			addressPrefixes: []string{"10.0.1.0/24", "10.0.2.0/24"},
+			expected:        true,
+		},
+		{
+			name:            "malformed prefix is skipped, not fatal",
+			ipAddress:       "10.0.2.5",
+			addressPrefixes: []string{"not-a-cidr", "10.0.2.0/24"},
+			expected:        true,
+		},
+		{
+			name:            "no prefixes match",
+			ipAddress:       "10.0.2.5",
+			addressPrefixes: []string{"not-a-cidr"},
+			expected:        false,
+		},
+		{
+			name:            "invalid ip address errors",
+			ipAddress:       "not-an-ip",
+			addressPrefixes: []string{"10.0.1.0/24"},
+			expectErr:       true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := privateIpAddressIsWithinAddressPrefixes(tc.ipAddress, tc.addressPrefixes)
+			if tc.expectErr {
+				if err == nil {
+					t.Fatalf("expected an error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error but got: %+v", err)
+			}
+			if actual != tc.expected {
+				t.Fatalf("expected %t but got %t", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestPrivateConnectionResourceTypeRequiresGroupId(t *testing.T) {
+	cases := []struct {
+		name       string
+		resourceId string
+		expected   bool
+	}{
+		{
+			name:       "storage account requires a groupId",
+			resourceId: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1",
+			expected:   true,
+		},
+		{
+			name:       "private link service does not require a groupId",
+			resourceId: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/privateLinkServices/pls1",
+			expected:   false,
+		},
+		{
+			name:       "unparsable resource id defaults to requiring a groupId",
+			resourceId: "not-a-resource-id",
+			expected:   true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := privateConnectionResourceTypeRequiresGroupId(tc.resourceId)
+			if actual != tc.expected {
+				t.Fatalf("expected %t but got %t", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestValidatePrivateServiceConnectionConfig(t *testing.T) {
+	plsId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/privateLinkServices/pls1"
+	storageId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1"
+
+	cases := []struct {
+		name                  string
+		isManual              bool
+		requestMessage        string
+		subresourceNameCount  int
+		privateConnResourceId string
+		expectErr             bool
+	}{
+		{
+			name:                  "manual connection with a message is valid",
+			isManual:              true,
+			requestMessage:        "please approve",
+			subresourceNameCount:  1,
+			privateConnResourceId: storageId,
+		},
+		{
+			name:                  "manual connection without a message is rejected",
+			isManual:              true,
+			requestMessage:        "",
+			subresourceNameCount:  1,
+			privateConnResourceId: storageId,
+			expectErr:             true,
+		},
+		{
+			name:                  "empty subresource_names against a storage account is rejected",
+			isManual:              false,
+			subresourceNameCount:  0,
+			privateConnResourceId: storageId,
+			expectErr:             true,
+		},
+		{
+			name:                  "empty subresource_names against a private link service is valid",
+			isManual:              false,
+			subresourceNameCount:  0,
+			privateConnResourceId: plsId,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validatePrivateServiceConnectionConfig(tc.isManual, tc.requestMessage, tc.subresourceNameCount, tc.privateConnResourceId)
+			if tc.expectErr && err == nil {
+				t.Fatalf("expected an error but got none")
+			}
+			if !tc.expectErr && err != nil {
+				t.Fatalf("expected no error but got: %+v", err)
+			}
+		})
+	}
+}